@@ -1,10 +1,11 @@
 package godax
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
-	"strconv"
-	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 // ListAccount represents a trading account for a coinbase pro profile.
@@ -24,11 +25,26 @@ type ListAccount struct {
 	// Currency - the currency of the account
 	Currency string `json:"currency"`
 	// Balance - the total funds in the account
-	Balance string `json:"balance"`
+	Balance Decimal `json:"balance"`
 	// Available - funds available to withdraw or trade
-	Available string `json:"available"`
+	Available Decimal `json:"available"`
 	// Hold - funds on hold (not available for use)
-	Hold string `json:"hold"`
+	Hold Decimal `json:"hold"`
+}
+
+// BalanceDecimal returns Balance as a decimal.Decimal for arithmetic.
+func (a ListAccount) BalanceDecimal() decimal.Decimal {
+	return a.Balance.Value
+}
+
+// AvailableDecimal returns Available as a decimal.Decimal for arithmetic.
+func (a ListAccount) AvailableDecimal() decimal.Decimal {
+	return a.Available.Value
+}
+
+// HoldDecimal returns Hold as a decimal.Decimal for arithmetic.
+func (a ListAccount) HoldDecimal() decimal.Decimal {
+	return a.Hold.Value
 }
 
 // Account describes information for a single account
@@ -45,15 +61,30 @@ type Account struct {
 	// ID - the account ID associated with the coinbase pro profile
 	ID string `json:"id"`
 	// Balance - the total funds in the account
-	Balance string `json:"balance"`
+	Balance Decimal `json:"balance"`
 	// Holds - funds on hold (not available for use)
-	Holds string `json:"holds"`
+	Holds Decimal `json:"holds"`
 	// Available - funds available to withdraw or trade
-	Available string `json:"available"`
+	Available Decimal `json:"available"`
 	// Currency - the currency of the account
 	Currency string `json:"currency"`
 }
 
+// BalanceDecimal returns Balance as a decimal.Decimal for arithmetic.
+func (a Account) BalanceDecimal() decimal.Decimal {
+	return a.Balance.Value
+}
+
+// HoldsDecimal returns Holds as a decimal.Decimal for arithmetic.
+func (a Account) HoldsDecimal() decimal.Decimal {
+	return a.Holds.Value
+}
+
+// AvailableDecimal returns Available as a decimal.Decimal for arithmetic.
+func (a Account) AvailableDecimal() decimal.Decimal {
+	return a.Available.Value
+}
+
 // AccountActivity represents an increase or decrease in your account balance.
 /*
 	{
@@ -75,9 +106,9 @@ type AccountActivity struct {
 	// CreatedAt - when did this activity happen
 	CreatedAt string
 	// Amount - the amount used in this activity
-	Amount string
+	Amount Decimal
 	// Balance - the total funds available
-	Balance string
+	Balance Decimal
 	// Type can be one of the following:
 	// "transfer"   - Funds moved to/from Coinbase to Coinbase Pro
 	// "match"      - Funds moved as a result of a trade
@@ -90,6 +121,16 @@ type AccountActivity struct {
 	Details ActivityDetail
 }
 
+// AmountDecimal returns Amount as a decimal.Decimal for arithmetic.
+func (a AccountActivity) AmountDecimal() decimal.Decimal {
+	return a.Amount.Value
+}
+
+// BalanceDecimal returns Balance as a decimal.Decimal for arithmetic.
+func (a AccountActivity) BalanceDecimal() decimal.Decimal {
+	return a.Balance.Value
+}
+
 // ActivityDetail describes important activity metadata (order, trade, and product IDs)
 type ActivityDetail struct {
 	OrderID   string
@@ -97,23 +138,39 @@ type ActivityDetail struct {
 	ProductID string
 }
 
-// listAccounts gets a list of trading accounts from the profile associated with the API key.
-func (c *Client) listAccounts() ([]ListAccount, error) {
-	path := "/accounts"
-
-	req, err := http.NewRequest(http.MethodGet, c.baseRestURL+path, nil)
-	if err != nil {
-		return []ListAccount{}, err
-	}
-
-	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
-	sig, err := c.generateSignature(timestamp, path, http.MethodGet, "")
-	if err != nil {
-		return []ListAccount{}, err
-	}
+// AccountHold represents a hold placed against an account for an active order or a
+// pending withdraw request.
+/*
+	{
+        "id": "82dcd140-c3c7-4507-8de4-2c529cd1a28f",
+        "account_id": "e0b3f39a-183d-453e-b754-0c13e5bab0b3",
+        "created_at": "2014-11-06T10:34:47.123456Z",
+        "updated_at": "2014-11-06T10:40:47.123456Z",
+        "amount": "4.23",
+        "type": "order",
+        "ref": "0a205de4-dd35-4370-a285-fe8fc375a273"
+    }
+*/
+type AccountHold struct {
+	// ID - the ID of this hold
+	ID string `json:"id"`
+	// AccountID - the account this hold is placed against
+	AccountID string `json:"account_id"`
+	// CreatedAt - when this hold was placed
+	CreatedAt string `json:"created_at"`
+	// UpdatedAt - when this hold was last updated
+	UpdatedAt string `json:"updated_at"`
+	// Amount - the amount held
+	Amount string `json:"amount"`
+	// Type is either "order" or "transfer"
+	Type string `json:"type"`
+	// Ref - the ID of the order or transfer that placed this hold
+	Ref string `json:"ref"`
+}
 
-	c.setHeaders(req, timestamp, sig)
-	res, err := c.httpClient.Do(req)
+// listAccounts gets a list of trading accounts from the profile associated with the API key.
+func (c *Client) listAccounts(ctx context.Context) ([]ListAccount, error) {
+	res, err := c.do(ctx, http.MethodGet, "/accounts", "", classAccounts)
 	if err != nil {
 		return []ListAccount{}, err
 	}
@@ -126,22 +183,8 @@ func (c *Client) listAccounts() ([]ListAccount, error) {
 }
 
 // getAccount retrieves information for a single account.
-func (c *Client) getAccount(accountID string) (Account, error) {
-	path := "/accounts/" + accountID
-
-	req, err := http.NewRequest(http.MethodGet, c.baseRestURL+path, nil)
-	if err != nil {
-		return Account{}, err
-	}
-
-	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
-	sig, err := c.generateSignature(timestamp, path, http.MethodGet, "")
-	if err != nil {
-		return Account{}, err
-	}
-
-	c.setHeaders(req, timestamp, sig)
-	res, err := c.httpClient.Do(req)
+func (c *Client) getAccount(ctx context.Context, accountID string) (Account, error) {
+	res, err := c.do(ctx, http.MethodGet, "/accounts/"+accountID, "", classAccounts)
 	if err != nil {
 		return Account{}, err
 	}
@@ -153,30 +196,48 @@ func (c *Client) getAccount(accountID string) (Account, error) {
 	return act, nil
 }
 
-// getAccountHistory retrieves information for a single account.
-func (c *Client) getAccountHistory(accountID string) ([]AccountActivity, error) {
+// getAccountHistory retrieves a single page of ledger activity for an account.
+func (c *Client) getAccountHistory(ctx context.Context, accountID string, opts Pagination) (PagedResult[AccountActivity], error) {
 	path := "/accounts/" + accountID + "/ledger"
+	if qs := opts.query(); qs != "" {
+		path += "?" + qs
+	}
 
-	req, err := http.NewRequest(http.MethodGet, c.baseRestURL+path, nil)
+	res, err := c.do(ctx, http.MethodGet, path, "", classPrivate)
 	if err != nil {
-		return []AccountActivity{}, err
+		return PagedResult[AccountActivity]{}, err
 	}
+	defer res.Body.Close()
 
-	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
-	sig, err := c.generateSignature(timestamp, path, http.MethodGet, "")
-	if err != nil {
-		return []AccountActivity{}, err
+	var aa []AccountActivity
+	json.NewDecoder(res.Body).Decode(&aa)
+
+	return PagedResult[AccountActivity]{
+		Items:  aa,
+		Before: res.Header.Get("CB-BEFORE"),
+		After:  res.Header.Get("CB-AFTER"),
+	}, nil
+}
+
+// getAccountHolds retrieves a single page of holds for an account.
+func (c *Client) getAccountHolds(ctx context.Context, accountID string, opts Pagination) (PagedResult[AccountHold], error) {
+	path := "/accounts/" + accountID + "/holds"
+	if qs := opts.query(); qs != "" {
+		path += "?" + qs
 	}
 
-	c.setHeaders(req, timestamp, sig)
-	res, err := c.httpClient.Do(req)
+	res, err := c.do(ctx, http.MethodGet, path, "", classPrivate)
 	if err != nil {
-		return []AccountActivity{}, err
+		return PagedResult[AccountHold]{}, err
 	}
 	defer res.Body.Close()
 
-	var aa []AccountActivity
-	json.NewDecoder(res.Body).Decode(&aa)
+	var ah []AccountHold
+	json.NewDecoder(res.Body).Decode(&ah)
 
-	return aa, nil
+	return PagedResult[AccountHold]{
+		Items:  ah,
+		Before: res.Header.Get("CB-BEFORE"),
+		After:  res.Header.Get("CB-AFTER"),
+	}, nil
 }
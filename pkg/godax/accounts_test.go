@@ -0,0 +1,108 @@
+package godax
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/bradford-hamilton/godax/pkg/godax/godaxtest"
+)
+
+const testAccountID = "71452118-efc7-4cc4-8780-a5e22d4baa53"
+
+func newReplayClient(t *testing.T) *Client {
+	t.Helper()
+
+	transport, err := godaxtest.SandboxFixtures()
+	if err != nil {
+		t.Fatalf("loading sandbox fixtures: %v", err)
+	}
+
+	return &Client{
+		baseRestURL: "https://api-public.sandbox.pro.coinbase.com",
+		key:         "test-key",
+		secret:      "c2VjcmV0",
+		passphrase:  "test-passphrase",
+		httpClient:  &http.Client{Transport: transport},
+		rateLimiter: defaultRateLimiter(),
+	}
+}
+
+func TestListAccounts(t *testing.T) {
+	c := newReplayClient(t)
+
+	accounts, err := c.ListAccounts()
+	if err != nil {
+		t.Fatalf("ListAccounts returned error: %v", err)
+	}
+
+	if len(accounts) != 1 {
+		t.Fatalf("expected 1 account, got %d", len(accounts))
+	}
+	if accounts[0].ID != testAccountID {
+		t.Errorf("expected account ID %q, got %q", testAccountID, accounts[0].ID)
+	}
+	if accounts[0].Currency != "BTC" {
+		t.Errorf("expected currency BTC, got %q", accounts[0].Currency)
+	}
+}
+
+func TestGetAccount(t *testing.T) {
+	c := newReplayClient(t)
+
+	account, err := c.GetAccount(testAccountID)
+	if err != nil {
+		t.Fatalf("GetAccount returned error: %v", err)
+	}
+
+	if account.ID != testAccountID {
+		t.Errorf("expected account ID %q, got %q", testAccountID, account.ID)
+	}
+	if !account.BalanceDecimal().Equal(account.AvailableDecimal().Add(account.HoldsDecimal())) {
+		t.Errorf("expected balance to equal available + holds, got balance=%s available=%s holds=%s",
+			account.Balance, account.Available, account.Holds)
+	}
+}
+
+func TestGetAccountHistory(t *testing.T) {
+	c := newReplayClient(t)
+
+	page, err := c.GetAccountHistory(testAccountID, Pagination{})
+	if err != nil {
+		t.Fatalf("GetAccountHistory returned error: %v", err)
+	}
+
+	if len(page.Items) != 1 {
+		t.Fatalf("expected 1 ledger entry, got %d", len(page.Items))
+	}
+	if page.Items[0].Type != "fee" {
+		t.Errorf("expected type fee, got %q", page.Items[0].Type)
+	}
+	if page.Before != "1" || page.After != "2" {
+		t.Errorf("expected cursors before=1 after=2, got before=%q after=%q", page.Before, page.After)
+	}
+}
+
+func TestGetAccountHolds(t *testing.T) {
+	c := newReplayClient(t)
+
+	page, err := c.GetAccountHolds(testAccountID, Pagination{})
+	if err != nil {
+		t.Fatalf("GetAccountHolds returned error: %v", err)
+	}
+
+	if len(page.Items) != 1 {
+		t.Fatalf("expected 1 hold, got %d", len(page.Items))
+	}
+	if page.Items[0].Type != "order" {
+		t.Errorf("expected type order, got %q", page.Items[0].Type)
+	}
+	if page.Items[0].AccountID != testAccountID {
+		t.Errorf("expected account ID %q, got %q", testAccountID, page.Items[0].AccountID)
+	}
+	if page.Items[0].CreatedAt != "2014-11-06T10:34:47.123456Z" {
+		t.Errorf("expected created_at 2014-11-06T10:34:47.123456Z, got %q", page.Items[0].CreatedAt)
+	}
+	if page.Items[0].UpdatedAt != "2014-11-06T10:40:47.123456Z" {
+		t.Errorf("expected updated_at 2014-11-06T10:40:47.123456Z, got %q", page.Items[0].UpdatedAt)
+	}
+}
@@ -0,0 +1,45 @@
+package godax
+
+import (
+	"encoding/json"
+
+	"github.com/shopspring/decimal"
+)
+
+// Decimal wraps shopspring/decimal.Decimal so monetary fields round-trip Coinbase Pro's
+// string-encoded numbers without forcing every caller to parse them before doing
+// arithmetic. An empty string decodes to the zero Decimal.
+type Decimal struct {
+	Value decimal.Decimal
+}
+
+// String returns the decimal in Coinbase's string format, suitable for logging.
+func (d Decimal) String() string {
+	return d.Value.String()
+}
+
+// MarshalJSON encodes d as Coinbase's string format.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.Value.String())
+}
+
+// UnmarshalJSON decodes a Coinbase-formatted string into d.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		d.Value = decimal.Decimal{}
+		return nil
+	}
+
+	v, err := decimal.NewFromString(s)
+	if err != nil {
+		return err
+	}
+
+	d.Value = v
+	return nil
+}
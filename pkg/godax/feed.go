@@ -0,0 +1,434 @@
+package godax
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// FeedMessage is implemented by every message type the websocket feed can emit. FeedType
+// returns the message's "type" field, the discriminator Coinbase Pro itself uses.
+type FeedMessage interface {
+	FeedType() string
+}
+
+// HeartbeatMessage is sent on the "heartbeat" channel, roughly once a second per
+// subscribed product, and is useful for detecting a stalled connection.
+type HeartbeatMessage struct {
+	Type        string `json:"type"`
+	Sequence    int64  `json:"sequence"`
+	LastTradeID int64  `json:"last_trade_id"`
+	ProductID   string `json:"product_id"`
+	Time        string `json:"time"`
+}
+
+// FeedType implements FeedMessage.
+func (m *HeartbeatMessage) FeedType() string { return m.Type }
+
+// TickerMessage is sent on the "ticker" channel on every match and carries the current
+// best bid/ask along with 24h/30d rollups.
+type TickerMessage struct {
+	Type      string `json:"type"`
+	Sequence  int64  `json:"sequence"`
+	ProductID string `json:"product_id"`
+	Price     string `json:"price"`
+	Open24h   string `json:"open_24h"`
+	Volume24h string `json:"volume_24h"`
+	Low24h    string `json:"low_24h"`
+	High24h   string `json:"high_24h"`
+	Volume30d string `json:"volume_30d"`
+	BestBid   string `json:"best_bid"`
+	BestAsk   string `json:"best_ask"`
+	Side      string `json:"side"`
+	Time      string `json:"time"`
+	TradeID   int64  `json:"trade_id"`
+	LastSize  string `json:"last_size"`
+}
+
+// FeedType implements FeedMessage.
+func (m *TickerMessage) FeedType() string { return m.Type }
+
+// Level2SnapshotMessage is the first message sent on the "level2" channel for a product,
+// containing the full book at the time of subscription. Feed it to a Level2Book via
+// ApplySnapshot before applying any Level2UpdateMessage.
+type Level2SnapshotMessage struct {
+	Type      string     `json:"type"`
+	ProductID string     `json:"product_id"`
+	Bids      [][]string `json:"bids"`
+	Asks      [][]string `json:"asks"`
+}
+
+// FeedType implements FeedMessage.
+func (m *Level2SnapshotMessage) FeedType() string { return m.Type }
+
+// Level2UpdateMessage carries incremental changes to a product's order book following a
+// Level2SnapshotMessage. Each change is [side, price, new_size]; a new_size of "0" means
+// the level should be removed.
+type Level2UpdateMessage struct {
+	Type      string     `json:"type"`
+	ProductID string     `json:"product_id"`
+	Time      string     `json:"time"`
+	Changes   [][]string `json:"changes"`
+}
+
+// FeedType implements FeedMessage.
+func (m *Level2UpdateMessage) FeedType() string { return m.Type }
+
+// MatchMessage is sent on the "matches" channel (and "full") whenever two orders trade.
+type MatchMessage struct {
+	Type         string `json:"type"`
+	TradeID      int64  `json:"trade_id"`
+	Sequence     int64  `json:"sequence"`
+	MakerOrderID string `json:"maker_order_id"`
+	TakerOrderID string `json:"taker_order_id"`
+	Time         string `json:"time"`
+	ProductID    string `json:"product_id"`
+	Size         string `json:"size"`
+	Price        string `json:"price"`
+	Side         string `json:"side"`
+}
+
+// FeedType implements FeedMessage.
+func (m *MatchMessage) FeedType() string { return m.Type }
+
+// FullMessage is sent on the "full" channel for every order lifecycle event: received,
+// open, done, change, and activate.
+type FullMessage struct {
+	Type          string `json:"type"`
+	Sequence      int64  `json:"sequence"`
+	OrderID       string `json:"order_id"`
+	OrderType     string `json:"order_type,omitempty"`
+	Size          string `json:"size,omitempty"`
+	Price         string `json:"price,omitempty"`
+	Side          string `json:"side"`
+	ProductID     string `json:"product_id"`
+	Time          string `json:"time"`
+	RemainingSize string `json:"remaining_size,omitempty"`
+	Reason        string `json:"reason,omitempty"`
+}
+
+// FeedType implements FeedMessage.
+func (m *FullMessage) FeedType() string { return m.Type }
+
+// UserMessage is sent on the authenticated "user" channel whenever an order belonging to
+// the subscribing API key's profile is filled. decodeFeedMessage has no case that
+// produces a UserMessage, so a Feed does not emit this type.
+type UserMessage struct {
+	Type      string `json:"type"`
+	Sequence  int64  `json:"sequence"`
+	OrderID   string `json:"order_id"`
+	ProfileID string `json:"profile_id"`
+	TradeID   int64  `json:"trade_id"`
+	ProductID string `json:"product_id"`
+	Size      string `json:"size"`
+	Price     string `json:"price"`
+	Side      string `json:"side"`
+	Time      string `json:"time"`
+}
+
+// FeedType implements FeedMessage.
+func (m *UserMessage) FeedType() string { return m.Type }
+
+// ErrorMessage is sent by Coinbase Pro in place of any other message when a subscribe
+// request is malformed or the connection is being terminated server-side.
+type ErrorMessage struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+	Reason  string `json:"reason"`
+}
+
+// FeedType implements FeedMessage.
+func (m *ErrorMessage) FeedType() string { return m.Type }
+
+// SubscribeRequest describes the products and channels a Feed should subscribe to. Include
+// "user" in Channels to subscribe to the authenticated user channel; this requires the
+// Client to have been constructed with API credentials. Messages on that channel are
+// decoded by their "type" field like any other; the Feed never emits a UserMessage.
+type SubscribeRequest struct {
+	ProductIDs []string
+	Channels   []string
+}
+
+// subscribeMessage is the wire format of a subscribe request, including the HMAC
+// authentication fields used when subscribing to the private "user" channel.
+type subscribeMessage struct {
+	Type       string   `json:"type"`
+	ProductIDs []string `json:"product_ids,omitempty"`
+	Channels   []string `json:"channels"`
+	Key        string   `json:"key,omitempty"`
+	Passphrase string   `json:"passphrase,omitempty"`
+	Timestamp  string   `json:"timestamp,omitempty"`
+	Signature  string   `json:"signature,omitempty"`
+}
+
+// Feed is a live subscription to the Coinbase Pro websocket feed. Create one with
+// Client.Subscribe. A Feed automatically reconnects and resubscribes after a transient
+// network error; call Close to tear it down for good.
+type Feed struct {
+	c   *Client
+	req SubscribeRequest
+
+	connMu sync.Mutex
+	conn   *websocket.Conn
+
+	messages chan FeedMessage
+	errors   chan error
+	done     chan struct{}
+	closeOne sync.Once
+}
+
+// Subscribe dials the websocket feed, sends an authenticated subscribe message built from
+// req, and returns a Feed that streams typed messages until ctx is canceled or Close is
+// called.
+func (c *Client) Subscribe(ctx context.Context, req SubscribeRequest) (*Feed, error) {
+	f := &Feed{
+		c:        c,
+		req:      req,
+		messages: make(chan FeedMessage, 256),
+		errors:   make(chan error, 16),
+		done:     make(chan struct{}),
+	}
+
+	if err := f.connect(ctx); err != nil {
+		return nil, err
+	}
+
+	go f.run(ctx)
+
+	return f, nil
+}
+
+// Messages returns the channel of incoming feed messages. It is closed once the Feed is
+// torn down for good (ctx canceled or Close called).
+func (f *Feed) Messages() <-chan FeedMessage {
+	return f.messages
+}
+
+// Errors returns the channel of non-fatal errors encountered while reading or decoding
+// feed messages, such as a dropped connection between reconnect attempts.
+func (f *Feed) Errors() <-chan error {
+	return f.errors
+}
+
+// Close tears down the Feed, closing the underlying connection and the Messages/Errors
+// channels. It is safe to call more than once.
+func (f *Feed) Close() error {
+	f.closeOne.Do(func() { close(f.done) })
+
+	f.connMu.Lock()
+	defer f.connMu.Unlock()
+	if f.conn != nil {
+		return f.conn.Close()
+	}
+	return nil
+}
+
+// connect dials the feed and sends the (re)subscribe message, replacing any existing
+// connection held by f.
+func (f *Feed) connect(ctx context.Context) error {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, f.c.baseWsURL, nil)
+	if err != nil {
+		return err
+	}
+
+	msg, err := f.c.signedSubscribeMessage(f.req)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	if err := conn.WriteJSON(msg); err != nil {
+		conn.Close()
+		return err
+	}
+
+	f.connMu.Lock()
+	if f.conn != nil {
+		f.conn.Close()
+	}
+	f.conn = conn
+	f.connMu.Unlock()
+
+	return nil
+}
+
+// signedSubscribeMessage builds the subscribe request for req, signing it with the same
+// HMAC scheme generateSignature uses for REST requests when the Client carries API
+// credentials. Coinbase Pro signs websocket subscribe messages over the string
+// timestamp+"GET"+"/users/self/verify".
+func (c *Client) signedSubscribeMessage(req SubscribeRequest) (subscribeMessage, error) {
+	msg := subscribeMessage{
+		Type:       "subscribe",
+		ProductIDs: req.ProductIDs,
+		Channels:   req.Channels,
+	}
+
+	if c.key == "" {
+		return msg, nil
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	sig, err := c.generateSignature(timestamp, "/users/self/verify", http.MethodGet, "")
+	if err != nil {
+		return subscribeMessage{}, err
+	}
+
+	msg.Key = c.key
+	msg.Passphrase = c.passphrase
+	msg.Timestamp = timestamp
+	msg.Signature = sig
+
+	return msg, nil
+}
+
+// run reads messages off the connection until ctx is canceled or Close is called,
+// reconnecting and resubscribing whenever the read loop returns a transient error.
+func (f *Feed) run(ctx context.Context) {
+	defer close(f.messages)
+	defer close(f.errors)
+
+	for {
+		select {
+		case <-f.done:
+			return
+		case <-ctx.Done():
+			f.Close()
+			return
+		default:
+		}
+
+		err := f.readLoop(ctx)
+		if err == nil {
+			return
+		}
+
+		select {
+		case f.errors <- err:
+		default:
+		}
+
+		if !f.reconnect(ctx) {
+			return
+		}
+	}
+}
+
+// readLoop reads and dispatches messages from the current connection until it errors or
+// the Feed is torn down, in which case it returns nil.
+func (f *Feed) readLoop(ctx context.Context) error {
+	for {
+		select {
+		case <-f.done:
+			return nil
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		f.connMu.Lock()
+		conn := f.conn
+		f.connMu.Unlock()
+
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		msg, err := decodeFeedMessage(raw)
+		if err != nil {
+			select {
+			case f.errors <- err:
+			default:
+			}
+			continue
+		}
+
+		select {
+		case f.messages <- msg:
+		case <-f.done:
+			return nil
+		}
+	}
+}
+
+// ErrFeedReconnected is sent on Feed.Errors() immediately after a successful reconnect.
+// The connection was down for an unknown interval, so any Level2Book a caller is
+// maintaining from this Feed's level2 messages has a gap in it: callers should call
+// Level2Book.Reset and wait for a fresh Level2SnapshotMessage before applying further
+// Level2UpdateMessage diffs.
+var ErrFeedReconnected = errors.New("godax: feed reconnected, resync any Level2Book from a fresh snapshot")
+
+// reconnect re-dials and resubscribes with exponential backoff, capped at 30s between
+// attempts. It returns false once the Feed has been torn down or ctx canceled.
+func (f *Feed) reconnect(ctx context.Context) bool {
+	backoff := time.Second
+
+	for {
+		select {
+		case <-f.done:
+			return false
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+
+		if err := f.connect(ctx); err == nil {
+			select {
+			case f.errors <- ErrFeedReconnected:
+			default:
+			}
+			return true
+		}
+
+		backoff *= 2
+		if backoff > 30*time.Second {
+			backoff = 30 * time.Second
+		}
+	}
+}
+
+// decodeFeedMessage sniffs the "type" field of a raw feed message and unmarshals it into
+// the matching FeedMessage implementation.
+func decodeFeedMessage(raw []byte) (FeedMessage, error) {
+	var discriminator struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(raw, &discriminator); err != nil {
+		return nil, err
+	}
+
+	var msg FeedMessage
+	switch discriminator.Type {
+	case "heartbeat":
+		msg = &HeartbeatMessage{}
+	case "ticker":
+		msg = &TickerMessage{}
+	case "snapshot":
+		msg = &Level2SnapshotMessage{}
+	case "l2update":
+		msg = &Level2UpdateMessage{}
+	case "match", "last_match":
+		msg = &MatchMessage{}
+	case "received", "open", "done", "change", "activate":
+		msg = &FullMessage{}
+	case "error":
+		msg = &ErrorMessage{}
+	// No case produces UserMessage: "user" channel messages are decoded by their "type"
+	// like any other, and a type with no case here is returned as an error below.
+	default:
+		return nil, fmt.Errorf("godax: unrecognized feed message type %q", discriminator.Type)
+	}
+
+	if err := json.Unmarshal(raw, msg); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
@@ -1,9 +1,7 @@
 package godax
 
 import (
-	"net/http"
-	"strconv"
-	"time"
+	"context"
 )
 
 // Client is the main export of godax. All its fields are unexported.
@@ -15,87 +13,93 @@ type Client struct {
 	secret      string
 	passphrase  string
 	httpClient  HTTPClient
+	rateLimiter RateLimiter
 }
 
 // NewClient returns a godax Client that is hooked up to the live REST and web socket APIs.
-func NewClient() (*Client, error) {
-	return newClient(false)
+// By default requests are throttled to Coinbase Pro's documented quotas; pass
+// WithRateLimiter to override this.
+func NewClient(opts ...ClientOption) (*Client, error) {
+	return newClientWithOptions(false, opts)
 }
 
-// NewSandboxClient returns a godax Client that is hooked up to the sandbox REST and web socket APIs.
-func NewSandboxClient() (*Client, error) {
-	return newClient(true)
+// NewSandboxClient returns a godax Client that is hooked up to the sandbox REST and web
+// socket APIs. By default requests are throttled to Coinbase Pro's documented quotas;
+// pass WithRateLimiter to override this.
+func NewSandboxClient(opts ...ClientOption) (*Client, error) {
+	return newClientWithOptions(true, opts)
+}
+
+func newClientWithOptions(sandbox bool, opts []ClientOption) (*Client, error) {
+	c, err := newClient(sandbox)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.rateLimiter == nil {
+		c.rateLimiter = defaultRateLimiter()
+	}
+
+	return c, nil
 }
 
 // ListAccounts gets a list of trading accounts from the profile associated with the API key.
 // This endpoint requires either the "view" or "trade" permission. This endpoint has a custom
-// rate limit by profile ID: 25 requests per second, up to 50 requests per second in bursts
+// rate limit by profile ID: 25 requests per second, up to 50 requests per second in bursts.
+// It is a thin wrapper around ListAccountsContext using context.Background().
 func (c *Client) ListAccounts() ([]ListAccount, error) {
-	method := http.MethodGet
-	path := "/accounts"
-	timestamp := unixTime()
-
-	sig, err := c.generateSignature(timestamp, path, method, "")
-	if err != nil {
-		return []ListAccount{}, err
-	}
+	return c.ListAccountsContext(context.Background())
+}
 
-	return c.listAccounts(method, path, timestamp, sig)
+// ListAccountsContext is ListAccounts with a caller-supplied context for cancellation.
+func (c *Client) ListAccountsContext(ctx context.Context) ([]ListAccount, error) {
+	return c.listAccounts(ctx)
 }
 
 // GetAccount retrieves information for a single account. Use this endpoint when you know the
 // account_id. API key must belong to the same profile as the account. This endpoint requires
-// either the "view" or "trade" permission.
+// either the "view" or "trade" permission. It is a thin wrapper around GetAccountContext using
+// context.Background().
 func (c *Client) GetAccount(accountID string) (Account, error) {
-	method := http.MethodGet
-	path := "/accounts/" + accountID
-	timestamp := unixTime()
-
-	sig, err := c.generateSignature(timestamp, path, method, "")
-	if err != nil {
-		return Account{}, err
-	}
+	return c.GetAccountContext(context.Background(), accountID)
+}
 
-	return c.getAccount(accountID, method, path, timestamp, sig)
+// GetAccountContext is GetAccount with a caller-supplied context for cancellation.
+func (c *Client) GetAccountContext(ctx context.Context, accountID string) (Account, error) {
+	return c.getAccount(ctx, accountID)
 }
 
 // GetAccountHistory lists account activity of the API key's profile. Account activity either increases
 // or decreases your account balance. If an entry is the result of a trade (match, fee), the details
 // field on an AccountActivity will contain additional information about the trade. Items are paginated
-// and sorted latest first. This endpoint requires either the "view" or "trade" permission.
-// TODO: paginate
-func (c *Client) GetAccountHistory(accountID string) ([]AccountActivity, error) {
-	method := http.MethodGet
-	path := "/accounts/" + accountID + "/ledger"
-	timestamp := unixTime()
-
-	sig, err := c.generateSignature(timestamp, path, method, "")
-	if err != nil {
-		return []AccountActivity{}, err
-	}
+// and sorted latest first; pass a zero-value Pagination to fetch the first page, or use IterAccountHistory
+// to walk every page automatically. This endpoint requires either the "view" or "trade" permission. It is
+// a thin wrapper around GetAccountHistoryContext using context.Background().
+func (c *Client) GetAccountHistory(accountID string, opts Pagination) (PagedResult[AccountActivity], error) {
+	return c.GetAccountHistoryContext(context.Background(), accountID, opts)
+}
 
-	return c.getAccountHistory(accountID, method, path, timestamp, sig)
+// GetAccountHistoryContext is GetAccountHistory with a caller-supplied context for cancellation.
+func (c *Client) GetAccountHistoryContext(ctx context.Context, accountID string, opts Pagination) (PagedResult[AccountActivity], error) {
+	return c.getAccountHistory(ctx, accountID, opts)
 }
 
 // GetAccountHolds lists holds of an account that belong to the same profile as the API key.
 // Holds are placed on an account for any active orders or pending withdraw requests. As an
 // order is filled, the hold amount is updated. If an order is canceled, any remaining hold
-// is removed. For a withdraw, once it is completed, the hold is removed. This endpoint
-// requires either the "view" or "trade" permission.
-// TODO: paginate
-func (c *Client) GetAccountHolds(accountID string) ([]AccountHold, error) {
-	method := http.MethodGet
-	path := "/accounts/" + accountID + "/holds"
-	timestamp := unixTime()
-
-	sig, err := c.generateSignature(timestamp, path, method, "")
-	if err != nil {
-		return []AccountHold{}, err
-	}
-
-	return c.getAccountHolds(accountID, method, path, timestamp, sig)
+// is removed. For a withdraw, once it is completed, the hold is removed. Items are paginated;
+// pass a zero-value Pagination to fetch the first page, or use IterAccountHolds to walk every
+// page automatically. This endpoint requires either the "view" or "trade" permission. It is a
+// thin wrapper around GetAccountHoldsContext using context.Background().
+func (c *Client) GetAccountHolds(accountID string, opts Pagination) (PagedResult[AccountHold], error) {
+	return c.GetAccountHoldsContext(context.Background(), accountID, opts)
 }
 
-func unixTime() string {
-	return strconv.FormatInt(time.Now().Unix(), 10)
+// GetAccountHoldsContext is GetAccountHolds with a caller-supplied context for cancellation.
+func (c *Client) GetAccountHoldsContext(ctx context.Context, accountID string, opts Pagination) (PagedResult[AccountHold], error) {
+	return c.getAccountHolds(ctx, accountID, opts)
 }
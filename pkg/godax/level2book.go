@@ -0,0 +1,97 @@
+package godax
+
+import "errors"
+
+// ErrLevel2Gap is returned by Level2Book.ApplyUpdate when an update arrives before the
+// book has been seeded with a snapshot, or after Reset invalidated it. Callers should
+// request a fresh snapshot and call ApplySnapshot before resuming ApplyUpdate.
+var ErrLevel2Gap = errors.New("godax: level2 update received before a snapshot")
+
+// Level2Book maintains an in-memory order book for a single product by applying a
+// Level2SnapshotMessage followed by a stream of Level2UpdateMessage diffs. Updates must be
+// applied strictly in the order they were received. Level2Book is not safe for concurrent
+// use.
+type Level2Book struct {
+	productID string
+	bids      map[string]string
+	asks      map[string]string
+}
+
+// NewLevel2Book creates an empty Level2Book for productID. Call ApplySnapshot before the
+// first ApplyUpdate to seed it.
+func NewLevel2Book(productID string) *Level2Book {
+	return &Level2Book{productID: productID}
+}
+
+// ApplySnapshot replaces the book's contents with msg, discarding any prior state.
+func (b *Level2Book) ApplySnapshot(msg *Level2SnapshotMessage) {
+	b.bids = make(map[string]string, len(msg.Bids))
+	b.asks = make(map[string]string, len(msg.Asks))
+
+	for _, lvl := range msg.Bids {
+		if len(lvl) == 2 {
+			b.bids[lvl[0]] = lvl[1]
+		}
+	}
+	for _, lvl := range msg.Asks {
+		if len(lvl) == 2 {
+			b.asks[lvl[0]] = lvl[1]
+		}
+	}
+}
+
+// ApplyUpdate applies a single l2update diff to the book, removing any level whose size
+// becomes "0" rather than storing it. It returns ErrLevel2Gap if called before
+// ApplySnapshot (or after Reset), since there is no earlier state to apply the diff to.
+func (b *Level2Book) ApplyUpdate(msg *Level2UpdateMessage) error {
+	if b.bids == nil || b.asks == nil {
+		return ErrLevel2Gap
+	}
+
+	for _, change := range msg.Changes {
+		if len(change) != 3 {
+			continue
+		}
+
+		side, price, size := change[0], change[1], change[2]
+		levels := b.asks
+		if side == "buy" {
+			levels = b.bids
+		}
+
+		if size == "0" {
+			delete(levels, price)
+			continue
+		}
+		levels[price] = size
+	}
+
+	return nil
+}
+
+// Reset invalidates the book. Call it on receiving ErrFeedReconnected from the Feed
+// driving this book, since the connection was down for an unknown interval and may have
+// missed updates. The next ApplyUpdate call returns ErrLevel2Gap until ApplySnapshot
+// reseeds it.
+func (b *Level2Book) Reset() {
+	b.bids = nil
+	b.asks = nil
+}
+
+// Bids returns a copy of the current bid levels, keyed by price.
+func (b *Level2Book) Bids() map[string]string {
+	return copyLevels(b.bids)
+}
+
+// Asks returns a copy of the current ask levels, keyed by price.
+func (b *Level2Book) Asks() map[string]string {
+	return copyLevels(b.asks)
+}
+
+func copyLevels(src map[string]string) map[string]string {
+	dst := make(map[string]string, len(src))
+	for k, v := range src {
+		dst[k] = v
+	}
+	return dst
+}
@@ -0,0 +1,93 @@
+package godax
+
+import "testing"
+
+func TestLevel2BookApplySnapshot(t *testing.T) {
+	b := NewLevel2Book("BTC-USD")
+
+	b.ApplySnapshot(&Level2SnapshotMessage{
+		Type:      "snapshot",
+		ProductID: "BTC-USD",
+		Bids:      [][]string{{"100.00", "1.0"}, {"99.50", "2.0"}},
+		Asks:      [][]string{{"101.00", "0.5"}},
+	})
+
+	bids := b.Bids()
+	if len(bids) != 2 || bids["100.00"] != "1.0" || bids["99.50"] != "2.0" {
+		t.Errorf("unexpected bids after snapshot: %v", bids)
+	}
+
+	asks := b.Asks()
+	if len(asks) != 1 || asks["101.00"] != "0.5" {
+		t.Errorf("unexpected asks after snapshot: %v", asks)
+	}
+}
+
+func TestLevel2BookApplyUpdateInOrder(t *testing.T) {
+	b := NewLevel2Book("BTC-USD")
+	b.ApplySnapshot(&Level2SnapshotMessage{
+		Bids: [][]string{{"100.00", "1.0"}},
+		Asks: [][]string{{"101.00", "0.5"}},
+	})
+
+	if err := b.ApplyUpdate(&Level2UpdateMessage{
+		Changes: [][]string{{"buy", "100.00", "1.5"}},
+	}); err != nil {
+		t.Fatalf("ApplyUpdate returned error: %v", err)
+	}
+	if got := b.Bids()["100.00"]; got != "1.5" {
+		t.Errorf("expected bid 100.00 updated to 1.5, got %q", got)
+	}
+
+	if err := b.ApplyUpdate(&Level2UpdateMessage{
+		Changes: [][]string{{"sell", "102.00", "0.25"}},
+	}); err != nil {
+		t.Fatalf("ApplyUpdate returned error: %v", err)
+	}
+	if got := b.Asks()["102.00"]; got != "0.25" {
+		t.Errorf("expected new ask 102.00 of 0.25, got %q", got)
+	}
+}
+
+func TestLevel2BookApplyUpdateDropsZeroSize(t *testing.T) {
+	b := NewLevel2Book("BTC-USD")
+	b.ApplySnapshot(&Level2SnapshotMessage{
+		Bids: [][]string{{"100.00", "1.0"}},
+	})
+
+	if err := b.ApplyUpdate(&Level2UpdateMessage{
+		Changes: [][]string{{"buy", "100.00", "0"}},
+	}); err != nil {
+		t.Fatalf("ApplyUpdate returned error: %v", err)
+	}
+
+	if _, ok := b.Bids()["100.00"]; ok {
+		t.Errorf("expected level 100.00 to be removed, still present")
+	}
+}
+
+func TestLevel2BookApplyUpdateBeforeSnapshotIsGap(t *testing.T) {
+	b := NewLevel2Book("BTC-USD")
+
+	err := b.ApplyUpdate(&Level2UpdateMessage{
+		Changes: [][]string{{"buy", "100.00", "1.0"}},
+	})
+	if err != ErrLevel2Gap {
+		t.Fatalf("expected ErrLevel2Gap, got %v", err)
+	}
+}
+
+func TestLevel2BookApplyUpdateAfterResetIsGap(t *testing.T) {
+	b := NewLevel2Book("BTC-USD")
+	b.ApplySnapshot(&Level2SnapshotMessage{
+		Bids: [][]string{{"100.00", "1.0"}},
+	})
+	b.Reset()
+
+	err := b.ApplyUpdate(&Level2UpdateMessage{
+		Changes: [][]string{{"buy", "100.00", "1.0"}},
+	})
+	if err != ErrLevel2Gap {
+		t.Fatalf("expected ErrLevel2Gap after Reset, got %v", err)
+	}
+}
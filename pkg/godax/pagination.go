@@ -0,0 +1,198 @@
+package godax
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// Pagination controls cursor-based paging for endpoints that return lists, such as
+// GetAccountHistory and GetAccountHolds. Coinbase Pro paginates using the CB-BEFORE and
+// CB-AFTER response headers: set Before to page toward newer entries and After to page
+// toward older ones. Limit caps the page size (the API's own default/max still apply).
+type Pagination struct {
+	Before string
+	After  string
+	Limit  int
+
+	// Reverse walks forward from After instead of backward from Before, useful for
+	// tailing new activity rather than paging through history.
+	Reverse bool
+}
+
+// query renders the pagination options as URL query parameters, omitting anything unset.
+func (p Pagination) query() string {
+	v := url.Values{}
+	if p.Before != "" {
+		v.Set("before", p.Before)
+	}
+	if p.After != "" {
+		v.Set("after", p.After)
+	}
+	if p.Limit > 0 {
+		v.Set("limit", strconv.Itoa(p.Limit))
+	}
+	return v.Encode()
+}
+
+// PagedResult wraps a single page of items together with the cursors Coinbase Pro
+// returned for it. Before and After are empty once there is no further page in that
+// direction.
+type PagedResult[T any] struct {
+	Items  []T
+	Before string
+	After  string
+}
+
+// AccountActivityIter walks the pages of an account's ledger, fetching the next page
+// transparently as the current one is exhausted. Create one with IterAccountHistory.
+type AccountActivityIter struct {
+	c         *Client
+	accountID string
+	opts      Pagination
+
+	items   []AccountActivity
+	idx     int
+	started bool
+	done    bool
+	err     error
+}
+
+// IterAccountHistory returns an iterator over accountID's ledger, starting from opts.
+// Ledger entries are sorted latest-first, so the default (forward) iteration walks
+// backward in time. Set opts.Reverse to walk forward from opts.After instead, which is
+// useful for tailing new activity.
+func (c *Client) IterAccountHistory(accountID string, opts Pagination) *AccountActivityIter {
+	return &AccountActivityIter{c: c, accountID: accountID, opts: opts}
+}
+
+// Next advances the iterator, fetching another page if the current one is exhausted.
+// It returns false when iteration is complete or a page fetch fails, including because
+// ctx was canceled; callers should then check Err. Items of a page already fetched are
+// returned without consulting ctx.
+func (it *AccountActivityIter) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.idx >= len(it.items) {
+		if it.started && it.done {
+			return false
+		}
+
+		page, err := it.c.getAccountHistory(ctx, it.accountID, it.opts)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.started = true
+		it.items = page.Items
+
+		if it.opts.Reverse {
+			it.opts.Before = page.Before
+			it.opts.After = ""
+			it.done = page.Before == ""
+		} else {
+			it.opts.After = page.After
+			it.opts.Before = ""
+			it.done = page.After == ""
+		}
+		it.idx = 0
+
+		if len(it.items) == 0 {
+			if it.done {
+				return false
+			}
+			continue
+		}
+	}
+
+	it.idx++
+	return true
+}
+
+// Value returns the current AccountActivity. Only valid after a call to Next returns true.
+func (it *AccountActivityIter) Value() AccountActivity {
+	return it.items[it.idx-1]
+}
+
+// Err returns the first error encountered while iterating, if any.
+func (it *AccountActivityIter) Err() error {
+	return it.err
+}
+
+// AccountHoldIter walks the pages of an account's holds, fetching the next page
+// transparently as the current one is exhausted. Create one with IterAccountHolds.
+type AccountHoldIter struct {
+	c         *Client
+	accountID string
+	opts      Pagination
+
+	items   []AccountHold
+	idx     int
+	started bool
+	done    bool
+	err     error
+}
+
+// IterAccountHolds returns an iterator over accountID's holds, starting from opts.
+func (c *Client) IterAccountHolds(accountID string, opts Pagination) *AccountHoldIter {
+	return &AccountHoldIter{c: c, accountID: accountID, opts: opts}
+}
+
+// Next advances the iterator, fetching another page if the current one is exhausted.
+// It returns false when iteration is complete or a page fetch fails, including because
+// ctx was canceled; callers should then check Err. Items of a page already fetched are
+// returned without consulting ctx.
+func (it *AccountHoldIter) Next(ctx context.Context) bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.idx >= len(it.items) {
+		if it.started && it.done {
+			return false
+		}
+
+		page, err := it.c.getAccountHolds(ctx, it.accountID, it.opts)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		it.started = true
+		it.items = page.Items
+
+		if it.opts.Reverse {
+			it.opts.Before = page.Before
+			it.opts.After = ""
+			it.done = page.Before == ""
+		} else {
+			it.opts.After = page.After
+			it.opts.Before = ""
+			it.done = page.After == ""
+		}
+		it.idx = 0
+
+		if len(it.items) == 0 {
+			if it.done {
+				return false
+			}
+			continue
+		}
+	}
+
+	it.idx++
+	return true
+}
+
+// Value returns the current AccountHold. Only valid after a call to Next returns true.
+func (it *AccountHoldIter) Value() AccountHold {
+	return it.items[it.idx-1]
+}
+
+// Err returns the first error encountered while iterating, if any.
+func (it *AccountHoldIter) Err() error {
+	return it.err
+}
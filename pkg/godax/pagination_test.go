@@ -0,0 +1,128 @@
+package godax
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// stubHTTPClient implements HTTPClient by returning a scripted response looked up by the
+// request's query string, so tests can script successive pages without a real server.
+type stubHTTPClient struct {
+	responses map[string]*http.Response
+}
+
+func (s *stubHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	res, ok := s.responses[req.URL.RawQuery]
+	if !ok {
+		return nil, fmt.Errorf("stubHTTPClient: no response scripted for query %q", req.URL.RawQuery)
+	}
+	return res, nil
+}
+
+func jsonResponse(body string, headers map[string]string) *http.Response {
+	h := http.Header{}
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     h,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func newStubClient(responses map[string]*http.Response) *Client {
+	return &Client{
+		baseRestURL: "https://api-public.sandbox.pro.coinbase.com",
+		key:         "test-key",
+		secret:      "c2VjcmV0",
+		passphrase:  "test-passphrase",
+		httpClient:  &stubHTTPClient{responses: responses},
+		rateLimiter: defaultRateLimiter(),
+	}
+}
+
+func TestIterAccountHistoryWalksMultiplePages(t *testing.T) {
+	c := newStubClient(map[string]*http.Response{
+		"": jsonResponse(
+			`[{"id":"1","type":"fee"}]`,
+			map[string]string{"CB-BEFORE": "50", "CB-AFTER": "40"},
+		),
+		"after=40": jsonResponse(
+			`[{"id":"2","type":"match"}]`,
+			map[string]string{"CB-AFTER": ""},
+		),
+	})
+
+	it := c.IterAccountHistory(testAccountID, Pagination{})
+
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(ids) != 2 || ids[0] != "1" || ids[1] != "2" {
+		t.Fatalf("expected to walk both pages in order, got %v", ids)
+	}
+}
+
+func TestIterAccountHistoryReverseWalksTowardPresent(t *testing.T) {
+	c := newStubClient(map[string]*http.Response{
+		"after=10": jsonResponse(
+			`[{"id":"3","type":"fee"}]`,
+			map[string]string{"CB-BEFORE": "20"},
+		),
+		"before=20": jsonResponse(
+			`[{"id":"4","type":"match"}]`,
+			map[string]string{"CB-BEFORE": ""},
+		),
+	})
+
+	it := c.IterAccountHistory(testAccountID, Pagination{After: "10", Reverse: true})
+
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(ids) != 2 || ids[0] != "3" || ids[1] != "4" {
+		t.Fatalf("expected to walk both pages in order, got %v", ids)
+	}
+}
+
+func TestIterAccountHoldsWalksMultiplePages(t *testing.T) {
+	c := newStubClient(map[string]*http.Response{
+		"": jsonResponse(
+			`[{"id":"h1","type":"order"}]`,
+			map[string]string{"CB-AFTER": "5"},
+		),
+		"after=5": jsonResponse(
+			`[{"id":"h2","type":"transfer"}]`,
+			map[string]string{"CB-AFTER": ""},
+		),
+	})
+
+	it := c.IterAccountHolds(testAccountID, Pagination{})
+
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(ids) != 2 || ids[0] != "h1" || ids[1] != "h2" {
+		t.Fatalf("expected to walk both pages in order, got %v", ids)
+	}
+}
@@ -0,0 +1,134 @@
+package godax
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// EndpointClass identifies which of Coinbase Pro's rate-limit buckets a request falls
+// into. Public endpoints are throttled per IP, private endpoints per profile/API key,
+// and a handful of endpoints (currently just /accounts) get their own higher quota.
+type EndpointClass int
+
+const (
+	classPublic EndpointClass = iota
+	classPrivate
+	classAccounts
+)
+
+// RateLimiter throttles outgoing requests so a Client stays within Coinbase Pro's
+// published throttle limits. Wait blocks until a request in the given class may
+// proceed, or ctx is canceled.
+type RateLimiter interface {
+	Wait(ctx context.Context, class EndpointClass) error
+}
+
+// tokenBucketLimiter is the default RateLimiter. It maintains one golang.org/x/time/rate
+// bucket per endpoint class, sized to Coinbase Pro's documented limits: 3/6 req/s for
+// public endpoints, 5/10 req/s for private endpoints, and 25/50 req/s for /accounts.
+type tokenBucketLimiter struct {
+	public   *rate.Limiter
+	private  *rate.Limiter
+	accounts *rate.Limiter
+}
+
+// defaultRateLimiter returns a tokenBucketLimiter configured with Coinbase Pro's
+// documented throttle limits.
+func defaultRateLimiter() RateLimiter {
+	return &tokenBucketLimiter{
+		public:   rate.NewLimiter(rate.Limit(3), 6),
+		private:  rate.NewLimiter(rate.Limit(5), 10),
+		accounts: rate.NewLimiter(rate.Limit(25), 50),
+	}
+}
+
+func (l *tokenBucketLimiter) Wait(ctx context.Context, class EndpointClass) error {
+	switch class {
+	case classAccounts:
+		return l.accounts.Wait(ctx)
+	case classPrivate:
+		return l.private.Wait(ctx)
+	default:
+		return l.public.Wait(ctx)
+	}
+}
+
+// ClientOption customizes a Client built by NewClient or NewSandboxClient.
+type ClientOption func(*Client)
+
+// WithRateLimiter overrides the default token-bucket RateLimiter with a custom one,
+// e.g. to share quota across multiple Client instances or to disable throttling in tests.
+func WithRateLimiter(rl RateLimiter) ClientOption {
+	return func(c *Client) {
+		c.rateLimiter = rl
+	}
+}
+
+// RateLimitError is returned when Coinbase Pro responds 429 Too Many Requests. RetryAfter
+// is parsed from the response's Retry-After header, when present.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("godax: rate limited by coinbase pro, retry after %s", e.RetryAfter)
+}
+
+// do waits on the appropriate RateLimiter bucket for class, then builds, signs, and sends
+// a method/path/body request, translating a 429 response into a *RateLimitError. Every
+// Client method should send its requests through do rather than calling c.httpClient.Do
+// directly. Signing happens only after the wait returns, so CB-ACCESS-TIMESTAMP reflects
+// when the request is actually sent rather than when it was queued behind the limiter —
+// signing it beforehand risks the timestamp aging out of Coinbase Pro's freshness window
+// while the request sits in the bucket.
+func (c *Client) do(ctx context.Context, method, path, body string, class EndpointClass) (*http.Response, error) {
+	if err := c.rateLimiter.Wait(ctx, class); err != nil {
+		return nil, err
+	}
+
+	var bodyReader io.Reader
+	if body != "" {
+		bodyReader = strings.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseRestURL+path, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	sig, err := c.generateSignature(timestamp, path, method, body)
+	if err != nil {
+		return nil, err
+	}
+	c.setHeaders(req, timestamp, sig)
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusTooManyRequests {
+		defer res.Body.Close()
+		return nil, &RateLimitError{RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"))}
+	}
+
+	return res, nil
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds, returning 0 if it is
+// absent or malformed.
+func parseRetryAfter(h string) time.Duration {
+	secs, err := strconv.Atoi(h)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}